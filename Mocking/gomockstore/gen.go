@@ -0,0 +1,6 @@
+// Package gomockstore holds a mockgen-generated counterpart to the
+// mockery-generated MockUserStore in the parent mocking package, so the
+// two idioms can be compared against the same UserStore interface.
+package gomockstore
+
+//go:generate mockgen -destination=mock_userstore.go -package=gomockstore github.com/FlutterDevPub/Blog/Mocking UserStore
@@ -0,0 +1,35 @@
+package gomockstore_test
+
+import (
+	"testing"
+
+	"go.uber.org/mock/gomock"
+
+	"github.com/FlutterDevPub/Blog/Mocking"
+	"github.com/FlutterDevPub/Blog/Mocking/gomockstore"
+)
+
+// TestGetUserName shows the gomock idiom side by side with the On(...)
+// call-string matching used by the mockery-generated MockUserStore:
+// EXPECT() names the method directly, so a misspelled method fails to
+// compile; Return values are checked against the real method signature
+// when Return is called; and ctrl.Finish() fails the test if GetUser
+// was never called.
+func TestGetUserName(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	store := gomockstore.NewMockUserStore(ctrl)
+	store.EXPECT().
+		GetUser("Cube").
+		Return(&mocking.User{Name: "Jakub", Surname: "Martin", Age: 18}, nil).
+		Times(1)
+
+	user, err := store.GetUser("Cube")
+	if err != nil {
+		t.Fatalf("GetUser returned unexpected error: %v", err)
+	}
+	if user.Name != "Jakub" {
+		t.Errorf("got name %q, want %q", user.Name, "Jakub")
+	}
+}
@@ -0,0 +1,75 @@
+package mocking
+
+import (
+	"sync"
+
+	"github.com/pkg/errors"
+)
+
+// InMemoryUserStore is a hand-written fake implementation of UserStore,
+// backed by a map instead of recorded expectations. Where MockUserStore
+// is useful for asserting that GetUser/SetUser were called with the
+// right arguments, InMemoryUserStore is useful for asserting on the
+// resulting state: did the user actually end up persisted?
+type InMemoryUserStore struct {
+	mu    sync.RWMutex
+	users map[string]*User
+
+	// FailNextGet and FailNextSet, when set, are returned by the next
+	// call to GetUser/SetUser instead of the usual behaviour, and are
+	// then cleared.
+	FailNextGet error
+	FailNextSet error
+}
+
+// NewInMemoryUserStore returns an InMemoryUserStore ready to use.
+func NewInMemoryUserStore() *InMemoryUserStore {
+	return &InMemoryUserStore{users: map[string]*User{}}
+}
+
+// GetUser implements UserStore.
+func (s *InMemoryUserStore) GetUser(name string) (*User, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.FailNextGet != nil {
+		err := s.FailNextGet
+		s.FailNextGet = nil
+		return nil, err
+	}
+
+	user, ok := s.users[name]
+	if !ok {
+		return nil, errors.Errorf("user %q not found", name)
+	}
+	return user, nil
+}
+
+// SetUser implements UserStore.
+func (s *InMemoryUserStore) SetUser(name string, user *User) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.FailNextSet != nil {
+		err := s.FailNextSet
+		s.FailNextSet = nil
+		return err
+	}
+
+	s.users[name] = user
+	return nil
+}
+
+// Snapshot returns a copy of the users currently stored, safe to
+// inspect without racing further writes.
+func (s *InMemoryUserStore) Snapshot() map[string]*User {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	out := make(map[string]*User, len(s.users))
+	for name, user := range s.users {
+		cp := *user
+		out[name] = &cp
+	}
+	return out
+}
@@ -0,0 +1,93 @@
+package mocking
+
+import (
+	"testing"
+
+	"github.com/pkg/errors"
+)
+
+// TestInMemoryUserStore_Snapshot asserts on end-state rather than on
+// how SetUser was called: this is the behaviour MockUserStore cannot
+// give you, since it only knows about the calls it was told to expect.
+func TestInMemoryUserStore_Snapshot(t *testing.T) {
+	store := NewInMemoryUserStore()
+	jakub := &User{Name: "Jakub", Surname: "Martin", Age: 18}
+
+	if err := store.SetUser("Cube", jakub); err != nil {
+		t.Fatalf("SetUser returned unexpected error: %v", err)
+	}
+
+	snapshot := store.Snapshot()
+	got, ok := snapshot["Cube"]
+	if !ok {
+		t.Fatalf("snapshot %+v does not contain %q", snapshot, "Cube")
+	}
+	if *got != *jakub {
+		t.Errorf("got %+v, want %+v", got, jakub)
+	}
+
+	// Snapshot is a copy: mutating it must not affect the store.
+	got.Age = 99
+	if stored, _ := store.GetUser("Cube"); stored.Age != jakub.Age {
+		t.Errorf("mutating the snapshot changed the stored user: got age %d, want %d", stored.Age, jakub.Age)
+	}
+}
+
+func TestInMemoryUserStore_FailNextGet(t *testing.T) {
+	store := NewInMemoryUserStore()
+	jakub := &User{Name: "Jakub", Surname: "Martin", Age: 18}
+	if err := store.SetUser("Cube", jakub); err != nil {
+		t.Fatalf("SetUser returned unexpected error: %v", err)
+	}
+
+	injected := errors.New("redis is down")
+	store.FailNextGet = injected
+
+	if _, err := store.GetUser("Cube"); err != injected {
+		t.Fatalf("got error %v, want %v", err, injected)
+	}
+
+	// The injected failure is one-shot: the next call should see the
+	// real, already-persisted user again.
+	if store.FailNextGet != nil {
+		t.Error("FailNextGet should be cleared after it fires")
+	}
+	got, err := store.GetUser("Cube")
+	if err != nil {
+		t.Fatalf("GetUser returned unexpected error: %v", err)
+	}
+	if *got != *jakub {
+		t.Errorf("got %+v, want %+v", got, jakub)
+	}
+}
+
+func TestInMemoryUserStore_FailNextSet(t *testing.T) {
+	store := NewInMemoryUserStore()
+	jakub := &User{Name: "Jakub", Surname: "Martin", Age: 18}
+
+	injected := errors.New("redis is down")
+	store.FailNextSet = injected
+
+	if err := store.SetUser("Cube", jakub); err != injected {
+		t.Fatalf("got error %v, want %v", err, injected)
+	}
+	if _, ok := store.Snapshot()["Cube"]; ok {
+		t.Error("user should not have been persisted when SetUser failed")
+	}
+
+	// The injected failure is one-shot: a retry should succeed and the
+	// user should now show up in Snapshot().
+	if store.FailNextSet != nil {
+		t.Error("FailNextSet should be cleared after it fires")
+	}
+	if err := store.SetUser("Cube", jakub); err != nil {
+		t.Fatalf("SetUser returned unexpected error on retry: %v", err)
+	}
+	got, ok := store.Snapshot()["Cube"]
+	if !ok {
+		t.Fatal("expected user to be persisted after a successful retry")
+	}
+	if *got != *jakub {
+		t.Errorf("got %+v, want %+v", got, jakub)
+	}
+}
@@ -0,0 +1,73 @@
+package mocking
+
+import (
+	"context"
+
+	"github.com/stretchr/testify/mock"
+)
+
+// MockUserRepository is an autogenerated mock type for the
+// UserRepository type. Unlike MockUserStore it is generated without
+// -testonly, so it can be imported from redisstore's tests to run the
+// same contract against the mock and the real Redis-backed repository.
+type MockUserRepository struct {
+	mock.Mock
+}
+
+// Create provides a mock function with given fields: ctx, user
+func (_m *MockUserRepository) Create(ctx context.Context, user *User) (string, error) {
+	ret := _m.Called(ctx, user)
+
+	var r0 string
+	if rf, ok := ret.Get(0).(func(context.Context, *User) string); ok {
+		r0 = rf(ctx, user)
+	} else {
+		r0 = ret.String(0)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *User) error); ok {
+		r1 = rf(ctx, user)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Delete provides a mock function with given fields: ctx, id
+func (_m *MockUserRepository) Delete(ctx context.Context, id string) error {
+	ret := _m.Called(ctx, id)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) error); ok {
+		r0 = rf(ctx, id)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// List provides a mock function with given fields: ctx
+func (_m *MockUserRepository) List(ctx context.Context) ([]*User, error) {
+	ret := _m.Called(ctx)
+
+	var r0 []*User
+	if rf, ok := ret.Get(0).(func(context.Context) []*User); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*User)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
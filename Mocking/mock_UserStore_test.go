@@ -0,0 +1,107 @@
+package mocking
+
+import "github.com/stretchr/testify/mock"
+
+// MockUserStore is an autogenerated mock type for the UserStore type
+type MockUserStore struct {
+	mock.Mock
+}
+
+// GetUser provides a mock function with given fields: name
+func (_m *MockUserStore) GetUser(name string) (*User, error) {
+	ret := _m.Called(name)
+
+	var r0 *User
+	if rf, ok := ret.Get(0).(func(string) *User); ok {
+		r0 = rf(name)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*User)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(string) error); ok {
+		r1 = rf(name)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// SetUser provides a mock function with given fields: name, user
+func (_m *MockUserStore) SetUser(name string, user *User) error {
+	ret := _m.Called(name, user)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(string, *User) error); ok {
+		r0 = rf(name, user)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// EXPECT returns an object that allows expected calls to be registered
+// through typed builder methods instead of the stringly-typed On(...)
+// API, following mockery's "with-expecter: true" output.
+func (_m *MockUserStore) EXPECT() *MockUserStore_Expecter {
+	return &MockUserStore_Expecter{mock: &_m.Mock}
+}
+
+// MockUserStore_Expecter is the EXPECT() receiver for MockUserStore.
+type MockUserStore_Expecter struct {
+	mock *mock.Mock
+}
+
+// MockUserStore_GetUser_Call wraps the *mock.Call for a GetUser
+// expectation so Return and Run can be typed against UserStore.GetUser.
+type MockUserStore_GetUser_Call struct {
+	*mock.Call
+}
+
+// GetUser registers an expected call to GetUser.
+func (_e *MockUserStore_Expecter) GetUser(name interface{}) *MockUserStore_GetUser_Call {
+	return &MockUserStore_GetUser_Call{Call: _e.mock.On("GetUser", name)}
+}
+
+// Run sets a handler to be called before GetUser returns.
+func (_c *MockUserStore_GetUser_Call) Run(run func(name string)) *MockUserStore_GetUser_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string))
+	})
+	return _c
+}
+
+// Return sets the values to be returned by GetUser.
+func (_c *MockUserStore_GetUser_Call) Return(user *User, err error) *MockUserStore_GetUser_Call {
+	_c.Call.Return(user, err)
+	return _c
+}
+
+// MockUserStore_SetUser_Call wraps the *mock.Call for a SetUser
+// expectation so Return and Run can be typed against UserStore.SetUser.
+type MockUserStore_SetUser_Call struct {
+	*mock.Call
+}
+
+// SetUser registers an expected call to SetUser.
+func (_e *MockUserStore_Expecter) SetUser(name interface{}, user interface{}) *MockUserStore_SetUser_Call {
+	return &MockUserStore_SetUser_Call{Call: _e.mock.On("SetUser", name, user)}
+}
+
+// Run sets a handler to be called before SetUser returns.
+func (_c *MockUserStore_SetUser_Call) Run(run func(name string, user *User)) *MockUserStore_SetUser_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string), args[1].(*User))
+	})
+	return _c
+}
+
+// Return sets the value to be returned by SetUser.
+func (_c *MockUserStore_SetUser_Call) Return(err error) *MockUserStore_SetUser_Call {
+	_c.Call.Return(err)
+	return _c
+}
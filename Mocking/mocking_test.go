@@ -6,15 +6,25 @@ import (
 	"github.com/stretchr/testify/mock"
 )
 
-//go:generate mockery -inpkg -testonly -name=UserStore
+// Regenerated from .mockery.yaml, which sets with-expecter: true so the
+// output includes MockUserStore_Expecter and friends; a plain
+// `-name=UserStore` run would drop EXPECT() and break TestMyFunction.
+//go:generate mockery
 
+// This used to stub MockUserStore through the stringly-typed On(...)
+// API (see git history). With EXPECT() a misspelled method name fails to
+// compile instead of failing at runtime, and Return and Run are typed
+// against UserStore, so new tests should prefer it. The expected
+// arguments are still taken as interface{} (so matchers such as
+// mock.AnythingOfType fit), and their types are not checked at compile
+// time.
 func TestMyFunction(t *testing.T) {
 	mockedUserStore := MockUserStore{}
 
-	mockedUserStore.On("GetUser", "Cube").Return(&User{Name: "Jakub", Surname: "Martin", Age: 18}, nil)
-	mockedUserStore.On("GetUser", "Cube2").Return(nil, errors.Errorf("User not found."))
-	mockedUserStore.On("SetUser", "Cube", mock.AnythingOfType("*mocking.User")).Return(errors.Errorf("User already exists."))
-	mockedUserStore.On("SetUser", "Cube2", mock.AnythingOfType("*mocking.User")).Return(nil)
+	mockedUserStore.EXPECT().GetUser("Cube").Return(&User{Name: "Jakub", Surname: "Martin", Age: 18}, nil)
+	mockedUserStore.EXPECT().GetUser("Cube2").Return(nil, errors.Errorf("User not found."))
+	mockedUserStore.EXPECT().SetUser("Cube", mock.AnythingOfType("*mocking.User")).Return(errors.Errorf("User already exists."))
+	mockedUserStore.EXPECT().SetUser("Cube2", mock.AnythingOfType("*mocking.User")).Return(nil)
 
 	user, err := mockedUserStore.GetUser("Cube")
 	if err != nil {
@@ -0,0 +1,89 @@
+// Package redisstore is a Redis-backed mocking.UserRepository, used to
+// demonstrate layering MockUserRepository over a real persistence
+// contract: the same interface, exercised by one test against
+// MockUserRepository and against this implementation (backed by
+// miniredis).
+package redisstore
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/google/uuid"
+	"github.com/redis/go-redis/v9"
+
+	"github.com/FlutterDevPub/Blog/Mocking"
+)
+
+const usersIndexKey = "users"
+
+// UserRepository implements mocking.UserRepository on top of a Redis
+// client. Each user is stored as a JSON value under "user:<id>" and its
+// id is tracked in the "users" set so List doesn't need to scan keys.
+type UserRepository struct {
+	client *redis.Client
+}
+
+// NewUserRepository returns a UserRepository backed by client.
+func NewUserRepository(client *redis.Client) *UserRepository {
+	return &UserRepository{client: client}
+}
+
+// Create implements mocking.UserRepository.
+func (r *UserRepository) Create(ctx context.Context, user *mocking.User) (string, error) {
+	id := uuid.NewString()
+
+	data, err := json.Marshal(user)
+	if err != nil {
+		return "", err
+	}
+
+	pipe := r.client.TxPipeline()
+	pipe.Set(ctx, userKey(id), data, 0)
+	pipe.SAdd(ctx, usersIndexKey, id)
+	if _, err := pipe.Exec(ctx); err != nil {
+		return "", err
+	}
+
+	return id, nil
+}
+
+// Delete implements mocking.UserRepository.
+func (r *UserRepository) Delete(ctx context.Context, id string) error {
+	pipe := r.client.TxPipeline()
+	pipe.Del(ctx, userKey(id))
+	pipe.SRem(ctx, usersIndexKey, id)
+	_, err := pipe.Exec(ctx)
+	return err
+}
+
+// List implements mocking.UserRepository.
+func (r *UserRepository) List(ctx context.Context) ([]*mocking.User, error) {
+	ids, err := r.client.SMembers(ctx, usersIndexKey).Result()
+	if err != nil {
+		return nil, err
+	}
+
+	users := make([]*mocking.User, 0, len(ids))
+	for _, id := range ids {
+		data, err := r.client.Get(ctx, userKey(id)).Bytes()
+		if err == redis.Nil {
+			continue
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		var user mocking.User
+		if err := json.Unmarshal(data, &user); err != nil {
+			return nil, err
+		}
+		users = append(users, &user)
+	}
+
+	return users, nil
+}
+
+func userKey(id string) string {
+	return "user:" + id
+}
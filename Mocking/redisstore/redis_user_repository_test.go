@@ -0,0 +1,76 @@
+package redisstore_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/alicebob/miniredis/v2"
+	"github.com/redis/go-redis/v9"
+
+	"github.com/FlutterDevPub/Blog/Mocking"
+	"github.com/FlutterDevPub/Blog/Mocking/redisstore"
+)
+
+// TestUserRepositoryContract runs the same Create/List/Delete scenario
+// against both MockUserRepository and the miniredis-backed
+// redisstore.UserRepository, to prove the mock's wiring matches what
+// the real persistence layer actually does.
+func TestUserRepositoryContract(t *testing.T) {
+	ctx := context.Background()
+	jakub := &mocking.User{Name: "Jakub", Surname: "Martin", Age: 18}
+
+	newMock := func() mocking.UserRepository {
+		m := &mocking.MockUserRepository{}
+		m.On("Create", ctx, jakub).Return("cube-1", nil).Once()
+		m.On("List", ctx).Return([]*mocking.User{jakub}, nil).Once()
+		m.On("Delete", ctx, "cube-1").Return(nil).Once()
+		m.On("List", ctx).Return([]*mocking.User{}, nil).Once()
+		return m
+	}
+
+	newReal := func() mocking.UserRepository {
+		mr := miniredis.RunT(t)
+		client := redis.NewClient(&redis.Options{Addr: mr.Addr()})
+		t.Cleanup(func() { client.Close() })
+		return redisstore.NewUserRepository(client)
+	}
+
+	repos := map[string]func() mocking.UserRepository{
+		"MockUserRepository": newMock,
+		"UserRepository":     newReal,
+	}
+
+	for name, newRepo := range repos {
+		t.Run(name, func(t *testing.T) {
+			repo := newRepo()
+
+			id, err := repo.Create(ctx, jakub)
+			if err != nil {
+				t.Fatalf("Create returned unexpected error: %v", err)
+			}
+			if id == "" {
+				t.Fatal("expected Create to return a non-empty id")
+			}
+
+			users, err := repo.List(ctx)
+			if err != nil {
+				t.Fatalf("List returned unexpected error: %v", err)
+			}
+			if len(users) != 1 || *users[0] != *jakub {
+				t.Fatalf("got %+v, want a single entry matching %+v", users, jakub)
+			}
+
+			if err := repo.Delete(ctx, id); err != nil {
+				t.Fatalf("Delete returned unexpected error: %v", err)
+			}
+
+			users, err = repo.List(ctx)
+			if err != nil {
+				t.Fatalf("List returned unexpected error: %v", err)
+			}
+			if len(users) != 0 {
+				t.Fatalf("got %d users after delete, want 0", len(users))
+			}
+		})
+	}
+}
@@ -0,0 +1,13 @@
+package mocking
+
+import "context"
+
+// UserRepository is a sibling of UserStore shaped closer to a
+// production repository interface: every method takes a context, and
+// Create reports back the ID assigned to the new record instead of the
+// caller choosing a key up front.
+type UserRepository interface {
+	Create(ctx context.Context, user *User) (id string, err error)
+	Delete(ctx context.Context, id string) error
+	List(ctx context.Context) ([]*User, error)
+}
@@ -0,0 +1,54 @@
+package mocking
+
+import (
+	"testing"
+
+	"github.com/pkg/errors"
+)
+
+// TestUserStoreContract runs the same table of cases against both
+// MockUserStore and InMemoryUserStore to prove they satisfy the same
+// contract: the interaction-based double and the state-based fake
+// should behave identically from the caller's point of view.
+func TestUserStoreContract(t *testing.T) {
+	jakub := &User{Name: "Jakub", Surname: "Martin", Age: 18}
+
+	newMock := func() UserStore {
+		m := &MockUserStore{}
+		m.On("SetUser", "Cube", jakub).Return(nil)
+		m.On("GetUser", "Cube").Return(jakub, nil)
+		m.On("GetUser", "Missing").Return(nil, errors.Errorf("user %q not found", "Missing"))
+		return m
+	}
+
+	newFake := func() UserStore {
+		return NewInMemoryUserStore()
+	}
+
+	stores := map[string]func() UserStore{
+		"MockUserStore":     newMock,
+		"InMemoryUserStore": newFake,
+	}
+
+	for name, newStore := range stores {
+		t.Run(name, func(t *testing.T) {
+			store := newStore()
+
+			if err := store.SetUser("Cube", jakub); err != nil {
+				t.Fatalf("SetUser returned unexpected error: %v", err)
+			}
+
+			got, err := store.GetUser("Cube")
+			if err != nil {
+				t.Fatalf("GetUser returned unexpected error: %v", err)
+			}
+			if *got != *jakub {
+				t.Errorf("got %+v, want %+v", got, jakub)
+			}
+
+			if _, err := store.GetUser("Missing"); err == nil {
+				t.Error("expected an error for a user that was never set")
+			}
+		})
+	}
+}